@@ -2,10 +2,14 @@ package lnd
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 
@@ -18,11 +22,17 @@ import (
 
 const (
 	lndDefaultInvoiceExpiry = 3600
+
+	// lndDefaultPaymentTimeoutSeconds mirrors lncli's default for
+	// SendPaymentV2; the RPC rejects requests with timeout_seconds unset.
+	lndDefaultPaymentTimeoutSeconds = 60
 )
 
 type Client struct {
 	lnrpc.LightningClient
-	conn *pool.Conn
+	invoices invoicesrpc.InvoicesClient
+	router   routerrpc.RouterClient
+	conn     *pool.Conn
 }
 
 func (c *Client) Close() error {
@@ -60,6 +70,33 @@ func (l Backend) SubscribeInvoice(ctx context.Context, channelInvoice chan *mode
 	}
 }
 
+func (l Backend) SubscribeSingleInvoice(ctx context.Context, hash lntypes.Hash, updates chan<- *models.Invoice) error {
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return err
+	}
+	defer clt.Close()
+
+	cltInvoices, err := clt.invoices.SubscribeSingleInvoice(ctx, &invoicesrpc.SubscribeSingleInvoiceRequest{
+		RHash: hash[:],
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		invoice, err := cltInvoices.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		updates <- lookupInvoiceProtoToInvoice(invoice)
+	}
+}
+
 func (l Backend) Client(ctx context.Context) (*Client, error) {
 	conn, err := l.pool.Get(ctx)
 	if err != nil {
@@ -70,6 +107,8 @@ func (l Backend) Client(ctx context.Context) (*Client, error) {
 
 	return &Client{
 		LightningClient: lnrpc.NewLightningClient(conn.ClientConn),
+		invoices:        invoicesrpc.NewInvoicesClient(conn.ClientConn),
+		router:          routerrpc.NewRouterClient(conn.ClientConn),
 		conn:            conn,
 	}, nil
 }
@@ -122,6 +161,195 @@ func (l Backend) GetChannelBalance(ctx context.Context) (*models.ChannelBalance,
 	return balance, nil
 }
 
+func (l Backend) ListTransactions(ctx context.Context, startHeight, endHeight int32) ([]*models.Transaction, error) {
+	l.logger.Debug("List transactions...",
+		logging.Int64("start_height", int64(startHeight)),
+		logging.Int64("end_height", int64(endHeight)))
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer clt.Close()
+
+	req := &lnrpc.GetTransactionsRequest{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+
+	resp, err := clt.GetTransactions(ctx, req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	transactions := make([]*models.Transaction, len(resp.Transactions))
+	for i, tx := range resp.Transactions {
+		transactions[i] = transactionProtoToTransaction(tx)
+	}
+
+	l.logger.Debug("Transactions retrieved", logging.Int64("count", int64(len(transactions))))
+
+	return transactions, nil
+}
+
+func (l Backend) SubscribeTransactions(ctx context.Context, ch chan<- *models.Transaction) error {
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return err
+	}
+	defer clt.Close()
+
+	cltTransactions, err := clt.SubscribeTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		tx, err := cltTransactions.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		ch <- transactionProtoToTransaction(tx)
+	}
+}
+
+func (l Backend) NewAddress(ctx context.Context, kind models.AddressType) (string, error) {
+	l.logger.Debug("Generate new address...", logging.String("type", string(kind)))
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer clt.Close()
+
+	req := &lnrpc.NewAddressRequest{
+		Type: addressTypeToProto(kind),
+	}
+
+	resp, err := clt.NewAddress(ctx, req)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return resp.Address, nil
+}
+
+func (l Backend) SendCoins(ctx context.Context, addr string, amountSat int64, satPerVbyte uint64, sendAll bool) (*models.Transaction, error) {
+	l.logger.Debug("Send coins...",
+		logging.String("addr", addr),
+		logging.Int64("amount", amountSat))
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer clt.Close()
+
+	req := &lnrpc.SendCoinsRequest{
+		Addr:        addr,
+		Amount:      amountSat,
+		SatPerVbyte: satPerVbyte,
+		SendAll:     sendAll,
+	}
+
+	resp, err := clt.SendCoins(ctx, req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	transaction := &models.Transaction{
+		TxHash:        resp.Txid,
+		AmountSat:     amountSat,
+		DestAddresses: []string{addr},
+	}
+
+	if sendAll {
+		swept, err := l.lookupSweptTransaction(ctx, clt, resp.Txid)
+		if err != nil {
+			return nil, err
+		}
+		transaction = swept
+	}
+
+	l.logger.Debug("Coins sent", logging.Object("transaction", transaction))
+
+	return transaction, nil
+}
+
+const (
+	sendCoinsLookupAttempts = 3
+	sendCoinsLookupInterval = 500 * time.Millisecond
+)
+
+// lookupSweptTransaction resolves the amount of a send-all SendCoins call,
+// whose broadcast amount is unknown to the caller. lnd may not have the
+// transaction indexed yet right after SendCoins returns, so retry a few
+// times rather than reporting a fabricated zero amount.
+func (l Backend) lookupSweptTransaction(ctx context.Context, clt *Client, txHash string) (*models.Transaction, error) {
+	for attempt := 0; attempt < sendCoinsLookupAttempts; attempt++ {
+		if tx := l.lookupTransaction(ctx, clt, txHash); tx != nil {
+			return tx, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(sendCoinsLookupInterval):
+		}
+	}
+
+	return nil, errors.Errorf("swept amount for transaction %s not available yet", txHash)
+}
+
+func (l Backend) lookupTransaction(ctx context.Context, clt *Client, txHash string) *models.Transaction {
+	resp, err := clt.GetTransactions(ctx, &lnrpc.GetTransactionsRequest{})
+	if err != nil {
+		l.logger.Error("lookup swept transaction", logging.Error(err))
+		return nil
+	}
+
+	for _, tx := range resp.Transactions {
+		if tx.TxHash == txHash {
+			return transactionProtoToTransaction(tx)
+		}
+	}
+
+	return nil
+}
+
+func (l Backend) EstimateFee(ctx context.Context, addrToAmt map[string]int64, targetConf int32) (*models.FeeEstimate, error) {
+	l.logger.Debug("Estimate fee...", logging.Int64("target_conf", int64(targetConf)))
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer clt.Close()
+
+	req := &lnrpc.EstimateFeeRequest{
+		AddrToAmount: addrToAmt,
+		TargetConf:   targetConf,
+	}
+
+	resp, err := clt.EstimateFee(ctx, req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	estimate := &models.FeeEstimate{
+		FeeSat:             resp.FeeSat,
+		FeerateSatPerVbyte: resp.SatPerVbyte,
+	}
+
+	l.logger.Debug("Fee estimated", logging.Object("estimate", estimate))
+
+	return estimate, nil
+}
+
 func (l Backend) ListChannels(ctx context.Context, opt ...options.Channel) ([]*models.Channel, error) {
 	l.logger.Debug("List channels")
 
@@ -157,6 +385,65 @@ func (l Backend) ListChannels(ctx context.Context, opt ...options.Channel) ([]*m
 	return channels, nil
 }
 
+func (l Backend) ListClosedChannels(ctx context.Context, opt ...options.ClosedChannel) ([]*models.ClosedChannel, error) {
+	l.logger.Debug("List closed channels")
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer clt.Close()
+
+	opts := options.NewClosedChannelOptions(opt...)
+	req := &lnrpc.ClosedChannelsRequest{
+		Cooperative:     opts.Cooperative,
+		LocalForce:      opts.LocalForce,
+		RemoteForce:     opts.RemoteForce,
+		Breach:          opts.Breach,
+		FundingCanceled: opts.FundingCanceled,
+		Abandoned:       opts.Abandoned,
+	}
+
+	resp, err := clt.ClosedChannels(ctx, req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	channels := make([]*models.ClosedChannel, len(resp.Channels))
+	for i, channel := range resp.Channels {
+		channels[i] = closedChannelProtoToClosedChannel(channel)
+	}
+
+	l.logger.Debug("Closed channels retrieved", logging.Int64("count", int64(len(channels))))
+
+	return channels, nil
+}
+
+func (l Backend) SubscribeChannelEvents(ctx context.Context, ch chan<- *models.ChannelEvent) error {
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return err
+	}
+	defer clt.Close()
+
+	cltEvents, err := clt.SubscribeChannelEvents(ctx, &lnrpc.ChannelEventSubscription{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := cltEvents.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		ch <- l.channelEventProtoToChannelEvent(ctx, event)
+	}
+}
+
 func (l Backend) CreateInvoice(ctx context.Context, amount int64, desc string) (*models.Invoice, error) {
 	l.logger.Debug("Create invoice...",
 		logging.Int64("amount", amount),
@@ -212,6 +499,83 @@ func (l Backend) GetInvoice(ctx context.Context, RHash string) (*models.Invoice,
 	return invoice, nil
 }
 
+func (l Backend) AddHoldInvoice(ctx context.Context, hash lntypes.Hash, amount int64, desc string, expiry int64) (*models.Invoice, error) {
+	l.logger.Debug("Create hold invoice...",
+		logging.String("hash", hash.String()),
+		logging.Int64("amount", amount),
+		logging.String("desc", desc))
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer clt.Close()
+
+	if expiry == 0 {
+		expiry = lndDefaultInvoiceExpiry
+	}
+
+	req := &invoicesrpc.AddHoldInvoiceRequest{
+		Hash:   hash[:],
+		Value:  amount,
+		Memo:   desc,
+		Expiry: expiry,
+	}
+
+	resp, err := clt.invoices.AddHoldInvoice(ctx, req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	invoice := addHoldInvoiceProtoToInvoice(hash, req, resp)
+
+	l.logger.Debug("Hold invoice created", logging.Object("invoice", invoice))
+
+	return invoice, nil
+}
+
+func (l Backend) SettleInvoice(ctx context.Context, preimage lntypes.Preimage) error {
+	l.logger.Debug("Settle invoice...", logging.String("preimage", preimage.String()))
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return err
+	}
+	defer clt.Close()
+
+	_, err = clt.invoices.SettleInvoice(ctx, &invoicesrpc.SettleInvoiceMsg{
+		Preimage: preimage[:],
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	l.logger.Debug("Invoice settled", logging.String("preimage", preimage.String()))
+
+	return nil
+}
+
+func (l Backend) CancelInvoice(ctx context.Context, hash lntypes.Hash) error {
+	l.logger.Debug("Cancel invoice...", logging.String("hash", hash.String()))
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return err
+	}
+	defer clt.Close()
+
+	_, err = clt.invoices.CancelInvoice(ctx, &invoicesrpc.CancelInvoiceMsg{
+		PaymentHash: hash[:],
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	l.logger.Debug("Invoice canceled", logging.String("hash", hash.String()))
+
+	return nil
+}
+
 func (l Backend) SendPayment(ctx context.Context, payreq *models.PayReq) (*models.Payment, error) {
 	l.logger.Debug("Send payment...",
 		logging.String("destination", payreq.Destination),
@@ -238,6 +602,138 @@ func (l Backend) SendPayment(ctx context.Context, payreq *models.PayReq) (*model
 	return payment, nil
 }
 
+func (l Backend) SendPaymentStream(ctx context.Context, payreq *models.PayReq, opt ...options.Payment) (<-chan *models.PaymentUpdate, error) {
+	l.logger.Debug("Send payment stream...",
+		logging.String("destination", payreq.Destination),
+		logging.Int64("amount", payreq.Amount),
+	)
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.NewPaymentOptions(opt...)
+
+	timeoutSeconds := opts.TimeoutSeconds
+	if timeoutSeconds == 0 {
+		timeoutSeconds = lndDefaultPaymentTimeoutSeconds
+	}
+
+	req := &routerrpc.SendPaymentRequest{
+		PaymentRequest:   payreq.String,
+		MaxParts:         routerrpc.DefaultMaxParts,
+		FeeLimitMsat:     opts.MaxFeeMsat,
+		TimeoutSeconds:   timeoutSeconds,
+		CltvLimit:        opts.CltvLimit,
+		OutgoingChanIds:  opts.OutgoingChanIDs,
+		AllowSelfPayment: opts.AllowSelfPayment,
+	}
+
+	stream, err := clt.router.SendPaymentV2(ctx, req)
+	if err != nil {
+		clt.Close()
+		return nil, errors.WithStack(err)
+	}
+
+	updates := make(chan *models.PaymentUpdate)
+
+	go func() {
+		defer clt.Close()
+		defer close(updates)
+
+		for {
+			payment, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					l.logger.Error("send payment stream", logging.Error(err))
+				}
+				return
+			}
+
+			update := paymentProtoToPaymentUpdate(payment)
+
+			updates <- update
+
+			if update.Status == models.PaymentSucceeded || update.Status == models.PaymentFailed {
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+func (l Backend) ListPayments(ctx context.Context, opt ...options.PaymentList) ([]*models.Payment, uint64, error) {
+	l.logger.Debug("List payments")
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer clt.Close()
+
+	opts := options.NewPaymentListOptions(opt...)
+	req := &lnrpc.ListPaymentsRequest{
+		IndexOffset:       opts.IndexOffset,
+		MaxPayments:       opts.MaxItems,
+		Reversed:          opts.Reversed,
+		IncludeIncomplete: opts.IncludeIncomplete,
+		CreationDateStart: opts.CreationDateStart,
+		CreationDateEnd:   opts.CreationDateEnd,
+	}
+
+	resp, err := clt.ListPayments(ctx, req)
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	payments := make([]*models.Payment, len(resp.Payments))
+	for i, payment := range resp.Payments {
+		payments[i] = paymentProtoToPayment(payment)
+	}
+
+	l.logger.Debug("Payments retrieved", logging.Int64("count", int64(len(payments))))
+
+	return payments, resp.LastIndexOffset, nil
+}
+
+func (l Backend) ListInvoices(ctx context.Context, opt ...options.Invoice) ([]*models.Invoice, uint64, error) {
+	l.logger.Debug("List invoices")
+
+	clt, err := l.Client(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer clt.Close()
+
+	opts := options.NewInvoiceOptions(opt...)
+	req := &lnrpc.ListInvoiceRequest{
+		IndexOffset:    opts.IndexOffset,
+		NumMaxInvoices: opts.MaxItems,
+		Reversed:       opts.Reversed,
+	}
+
+	resp, err := clt.ListInvoices(ctx, req)
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	invoices := make([]*models.Invoice, 0, len(resp.Invoices))
+	for _, invoice := range resp.Invoices {
+		model := lookupInvoiceProtoToInvoice(invoice)
+		if !opts.IncludeIncomplete && model.State != models.InvoiceSettled {
+			continue
+		}
+
+		invoices = append(invoices, model)
+	}
+
+	l.logger.Debug("Invoices retrieved", logging.Int64("count", int64(len(invoices))))
+
+	return invoices, resp.LastIndexOffset, nil
+}
+
 func (l Backend) DecodePayReq(ctx context.Context, payreq string) (*models.PayReq, error) {
 	l.logger.Info("decode payreq", logging.String("payreq", payreq))
 	clt, err := l.Client(ctx)
@@ -268,4 +764,193 @@ func New(c *config.Network, logger logging.Logger) (*Backend, error) {
 	}
 
 	return backend, nil
+}
+
+func transactionProtoToTransaction(tx *lnrpc.Transaction) *models.Transaction {
+	return &models.Transaction{
+		TxHash:           tx.TxHash,
+		AmountSat:        tx.Amount,
+		NumConfirmations: tx.NumConfirmations,
+		BlockHeight:      tx.BlockHeight,
+		TotalFees:        tx.TotalFees,
+		DestAddresses:    tx.DestAddresses,
+		Label:            tx.Label,
+	}
+}
+
+func addressTypeToProto(kind models.AddressType) lnrpc.AddressType {
+	switch kind {
+	case models.AddressTypeNP2WKH:
+		return lnrpc.AddressType_NESTED_PUBKEY_HASH
+	case models.AddressTypeP2TR:
+		return lnrpc.AddressType_TAPROOT_PUBKEY
+	default:
+		return lnrpc.AddressType_WITNESS_PUBKEY_HASH
+	}
+}
+
+func closedChannelProtoToClosedChannel(channel *lnrpc.ChannelCloseSummary) *models.ClosedChannel {
+	resolutions := make([]*models.ChannelResolution, len(channel.Resolutions))
+	for i, resolution := range channel.Resolutions {
+		resolutions[i] = &models.ChannelResolution{
+			ResolutionType: resolution.ResolutionType.String(),
+			Outcome:        resolution.Outcome.String(),
+			SweepTxid:      resolution.SweepTxid,
+			AmountSat:      resolution.AmountSat,
+		}
+	}
+
+	return &models.ClosedChannel{
+		ChannelPoint:      channel.ChannelPoint,
+		ChanID:            channel.ChanId,
+		ClosingTxHash:     channel.ClosingTxHash,
+		RemotePubkey:      channel.RemotePubkey,
+		Capacity:          channel.Capacity,
+		CloseHeight:       channel.CloseHeight,
+		SettledBalance:    channel.SettledBalance,
+		TimeLockedBalance: channel.TimeLockedBalance,
+		CloseType:         channel.CloseType.String(),
+		Resolutions:       resolutions,
+	}
+}
+
+func (l Backend) channelEventProtoToChannelEvent(ctx context.Context, event *lnrpc.ChannelEventUpdate) *models.ChannelEvent {
+	channelEvent := &models.ChannelEvent{
+		Type: event.Type.String(),
+	}
+
+	switch e := event.Channel.(type) {
+	case *lnrpc.ChannelEventUpdate_OpenChannel:
+		channelEvent.ChannelPoint = e.OpenChannel.ChannelPoint
+		channelEvent.ChanID = e.OpenChannel.ChanId
+	case *lnrpc.ChannelEventUpdate_ClosedChannel:
+		channelEvent.ChannelPoint = e.ClosedChannel.ChannelPoint
+		channelEvent.ChanID = e.ClosedChannel.ChanId
+		channelEvent.ClosedChannel = closedChannelProtoToClosedChannel(e.ClosedChannel)
+	case *lnrpc.ChannelEventUpdate_ActiveChannel:
+		channelEvent.ChannelPoint = channelPointProtoToString(e.ActiveChannel)
+		channelEvent.ChanID = l.lookupChanID(ctx, channelEvent.ChannelPoint)
+	case *lnrpc.ChannelEventUpdate_InactiveChannel:
+		channelEvent.ChannelPoint = channelPointProtoToString(e.InactiveChannel)
+		channelEvent.ChanID = l.lookupChanID(ctx, channelEvent.ChannelPoint)
+	case *lnrpc.ChannelEventUpdate_FullyResolvedChannel:
+		channelEvent.ChannelPoint = channelPointProtoToString(e.FullyResolvedChannel)
+	}
+
+	return channelEvent
+}
+
+func channelPointProtoToString(point *lnrpc.ChannelPoint) string {
+	if txid := point.GetFundingTxidStr(); txid != "" {
+		return fmt.Sprintf("%s:%d", txid, point.OutputIndex)
+	}
+
+	txidBytes := point.GetFundingTxidBytes()
+	reversed := make([]byte, len(txidBytes))
+	for i, b := range txidBytes {
+		reversed[len(txidBytes)-1-i] = b
+	}
+
+	return fmt.Sprintf("%s:%d", hex.EncodeToString(reversed), point.OutputIndex)
+}
+
+func (l Backend) lookupChanID(ctx context.Context, channelPoint string) uint64 {
+	channels, err := l.ListChannels(ctx)
+	if err != nil {
+		l.logger.Error("lookup channel id", logging.Error(err))
+		return 0
+	}
+
+	for _, channel := range channels {
+		if channel.ChannelPoint == channelPoint {
+			return channel.ChanID
+		}
+	}
+
+	return 0
+}
+
+func paymentProtoToPayment(payment *lnrpc.Payment) *models.Payment {
+	attempts := make([]*models.PaymentAttempt, len(payment.Htlcs))
+	for i, htlc := range payment.Htlcs {
+		attempts[i] = htlcAttemptProtoToPaymentAttempt(htlc)
+	}
+
+	return &models.Payment{
+		PaymentHash:    payment.PaymentHash,
+		Destination:    payreqProtoDestination(payment),
+		Amount:         payment.ValueSat,
+		FeeSat:         payment.FeeSat,
+		CreationTimeNs: payment.CreationTimeNs,
+		Status:         models.PaymentStatus(payment.Status.String()),
+		HTLCs:          attempts,
+		FailureReason:  payment.FailureReason.String(),
+	}
+}
+
+func payreqProtoDestination(payment *lnrpc.Payment) string {
+	if len(payment.Htlcs) == 0 {
+		return ""
+	}
+
+	route := payment.Htlcs[len(payment.Htlcs)-1].Route
+	if len(route.Hops) == 0 {
+		return ""
+	}
+
+	return route.Hops[len(route.Hops)-1].PubKey
+}
+
+func paymentProtoToPaymentUpdate(payment *lnrpc.Payment) *models.PaymentUpdate {
+	attempts := make([]*models.PaymentAttempt, len(payment.Htlcs))
+	for i, htlc := range payment.Htlcs {
+		attempts[i] = htlcAttemptProtoToPaymentAttempt(htlc)
+	}
+
+	return &models.PaymentUpdate{
+		Status:          models.PaymentStatus(payment.Status.String()),
+		PaymentPreimage: payment.PaymentPreimage,
+		Attempts:        attempts,
+		FailureReason:   payment.FailureReason.String(),
+	}
+}
+
+func htlcAttemptProtoToPaymentAttempt(htlc *lnrpc.HTLCAttempt) *models.PaymentAttempt {
+	hops := make([]*models.Hop, len(htlc.Route.Hops))
+	for i, hop := range htlc.Route.Hops {
+		hops[i] = &models.Hop{
+			ChanID:           hop.ChanId,
+			PubKey:           hop.PubKey,
+			AmtToForwardMsat: hop.AmtToForwardMsat,
+			FeeMsat:          hop.FeeMsat,
+		}
+	}
+
+	attempt := &models.PaymentAttempt{
+		AttemptID:     htlc.AttemptId,
+		Status:        htlc.Status.String(),
+		FeeMsat:       htlc.Route.TotalFeesMsat,
+		Hops:          hops,
+		AttemptTimeNs: htlc.AttemptTimeNs,
+		ResolveTimeNs: htlc.ResolveTimeNs,
+	}
+
+	if htlc.Failure != nil {
+		attempt.FailureCode = htlc.Failure.Code.String()
+		attempt.FailureSourceIndex = htlc.Failure.FailureSourceIndex
+	}
+
+	return attempt
+}
+
+func addHoldInvoiceProtoToInvoice(hash lntypes.Hash, req *invoicesrpc.AddHoldInvoiceRequest, resp *invoicesrpc.AddHoldInvoiceResp) *models.Invoice {
+	return &models.Invoice{
+		Memo:           req.Memo,
+		RHash:          hash[:],
+		Value:          req.Value,
+		CreationDate:   time.Now().Unix(),
+		Expiry:         req.Expiry,
+		PaymentRequest: resp.PaymentRequest,
+		State:          models.InvoiceOpen,
+	}
 }
\ No newline at end of file